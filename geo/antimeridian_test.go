@@ -0,0 +1,71 @@
+package geo
+
+import "testing"
+
+func TestNewRectangleAntimeridianContains(t *testing.T) {
+	// A box stretching from 170°E to 170°W, wrapping the dateline.
+	rectangle := NewRectangle(
+		LatLng{Lat: -10, Lng: 170},
+		LatLng{Lat: 10, Lng: -170},
+	)
+
+	cases := []struct {
+		in   LatLng
+		want bool
+	}{
+		{
+			// 180°, inside the wrapped box.
+			LatLng{Lat: 0, Lng: 180},
+			true,
+		},
+		{
+			// 175°E, inside the wrapped box.
+			LatLng{Lat: 0, Lng: 175},
+			true,
+		},
+		{
+			// 0°, on the opposite side of the globe.
+			LatLng{Lat: 0, Lng: 0},
+			false,
+		},
+	}
+
+	for _, c := range cases {
+		got := rectangle.Contains(c.in)
+
+		if got != c.want {
+			t.Errorf("expected %t", c.want)
+			t.Errorf("     got %t", got)
+		}
+	}
+}
+
+func TestCircleBoundClampsAtPole(t *testing.T) {
+	// Centered 50km from the north pole with a 200km radius: the cap
+	// extends well past the pole.
+	circle := &Circle{Radius: 200000, Coordinates: []float64{0, 89.55}}
+
+	center, radius := circle.CircleBound()
+
+	if center.Lat != 90 {
+		t.Errorf("expected the bound to clamp latitude to the pole, got %v", center.Lat)
+	}
+
+	if radius <= circle.Radius {
+		t.Errorf("expected the pole-centered radius to still cover the original cap, got %v", radius)
+	}
+}
+
+func TestCircleBoundAwayFromPole(t *testing.T) {
+	circle := &Circle{Radius: 300, Coordinates: []float64{25.608530044555668, 43.07380969664719}}
+
+	center, radius := circle.CircleBound()
+
+	if center.Lat != circle.Coordinates[1] || center.Lng != circle.Coordinates[0] {
+		t.Errorf("expected the bound to keep the circle's own center when far from a pole")
+	}
+
+	if radius != circle.Radius {
+		t.Errorf("expected the bound radius to equal the circle's radius, got %v", radius)
+	}
+}
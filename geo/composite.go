@@ -0,0 +1,124 @@
+package geo
+
+import (
+	"github.com/golang/geo/s1"
+	"github.com/golang/geo/s2"
+)
+
+// GeometryCollection mirrors GeoJSON's GeometryCollection: an ordered
+// set of child Geometry values that, together, behave as their union.
+type GeometryCollection struct {
+	Type       string
+	Geometries []Geometry
+}
+
+// NewGeometryCollection creates a GeometryCollection from the provided
+// geometries.
+func NewGeometryCollection(geometries []Geometry) *GeometryCollection {
+	return &GeometryCollection{Type: "GeometryCollection", Geometries: geometries}
+}
+
+// Contains returns true if any child geometry contains the point.
+func (g *GeometryCollection) Contains(latlng LatLng) bool {
+	for _, geometry := range g.Geometries {
+		if geometry.Contains(latlng) {
+			return true
+		}
+	}
+	return false
+}
+
+// CircleBound returns the smallest cap enclosing every child's bound.
+func (g *GeometryCollection) CircleBound() (LatLng, float64) {
+	if len(g.Geometries) == 0 {
+		return LatLng{}, 0
+	}
+
+	cap := capBound(g.Geometries[0])
+	for _, geometry := range g.Geometries[1:] {
+		cap = cap.Union(capBound(geometry))
+	}
+
+	return latLngFromCap(cap)
+}
+
+// compositeOp identifies which boolean combination a composite performs.
+type compositeOp int
+
+const (
+	opUnion compositeOp = iota
+	opIntersection
+	opDifference
+)
+
+// composite is a Geometry built out of a boolean combination of two
+// other geometries, produced by Union, Intersection, and Difference.
+type composite struct {
+	op   compositeOp
+	a, b Geometry
+}
+
+// Union returns a Geometry that contains a point whenever a or b does.
+func Union(a, b Geometry) Geometry {
+	return &composite{op: opUnion, a: a, b: b}
+}
+
+// Intersection returns a Geometry that contains a point only when both
+// a and b do.
+func Intersection(a, b Geometry) Geometry {
+	return &composite{op: opIntersection, a: a, b: b}
+}
+
+// Difference returns a Geometry that contains a point when a contains
+// it and b does not, e.g. "inside polygon A but outside circle B".
+func Difference(a, b Geometry) Geometry {
+	return &composite{op: opDifference, a: a, b: b}
+}
+
+func (c *composite) Contains(latlng LatLng) bool {
+	switch c.op {
+	case opUnion:
+		return c.a.Contains(latlng) || c.b.Contains(latlng)
+	case opIntersection:
+		return c.a.Contains(latlng) && c.b.Contains(latlng)
+	case opDifference:
+		return c.a.Contains(latlng) && !c.b.Contains(latlng)
+	default:
+		return false
+	}
+}
+
+func (c *composite) CircleBound() (LatLng, float64) {
+	capA := capBound(c.a)
+
+	switch c.op {
+	case opDifference:
+		return latLngFromCap(capA)
+	case opIntersection:
+		capB := capBound(c.b)
+		if capB.Radius() < capA.Radius() {
+			return latLngFromCap(capB)
+		}
+		return latLngFromCap(capA)
+	default:
+		return latLngFromCap(capA.Union(capBound(c.b)))
+	}
+}
+
+// capBound converts a Geometry's CircleBound into an s2.Cap so bounds
+// of several geometries can be combined with s2.Cap.Union.
+func capBound(g Geometry) s2.Cap {
+	center, radius := g.CircleBound()
+	point := s2.PointFromLatLng(s2.LatLngFromDegrees(center.Lat, center.Lng))
+	return s2.CapFromCenterAngle(point, s1.Angle(radius/6371000))
+}
+
+// latLngFromCap is the inverse of capBound: it turns an s2.Cap back
+// into the (center, radius in meters) pair Geometry.CircleBound returns.
+func latLngFromCap(cap s2.Cap) (LatLng, float64) {
+	latlng := s2.LatLngFromPoint(cap.Center())
+	return LatLng{
+		Lat: latlng.Lat.Degrees(),
+		Lng: latlng.Lng.Degrees(),
+	}, float64(cap.Radius() * 6371000)
+}
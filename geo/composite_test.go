@@ -0,0 +1,77 @@
+package geo
+
+import "testing"
+
+func TestGeometryCollectionContains(t *testing.T) {
+	collection := NewGeometryCollection([]Geometry{
+		&Point{Coordinates: []float64{25.60853, 43.07381}},
+		&Circle{Radius: 300, Coordinates: []float64{0, 0}},
+	})
+
+	cases := []struct {
+		in   LatLng
+		want bool
+	}{
+		{LatLng{Lat: 43.07381, Lng: 25.60853}, true},
+		{LatLng{Lat: 0.001, Lng: 0.001}, true},
+		{LatLng{Lat: 10, Lng: 10}, false},
+	}
+
+	for _, c := range cases {
+		got := collection.Contains(c.in)
+
+		if got != c.want {
+			t.Errorf("expected %t", c.want)
+			t.Errorf("     got %t", got)
+		}
+	}
+}
+
+func TestUnionContains(t *testing.T) {
+	a := &Circle{Radius: 300, Coordinates: []float64{0, 0}}
+	b := &Circle{Radius: 300, Coordinates: []float64{1, 1}}
+
+	union := Union(a, b)
+
+	if !union.Contains(LatLng{Lat: 0.001, Lng: 0.001}) {
+		t.Errorf("expected union to contain a point inside a")
+	}
+
+	if !union.Contains(LatLng{Lat: 1.001, Lng: 1.001}) {
+		t.Errorf("expected union to contain a point inside b")
+	}
+
+	if union.Contains(LatLng{Lat: 10, Lng: 10}) {
+		t.Errorf("expected union to not contain a point outside both")
+	}
+}
+
+func TestIntersectionContains(t *testing.T) {
+	a := &Circle{Radius: 500000, Coordinates: []float64{0, 0}}
+	b := &Circle{Radius: 500000, Coordinates: []float64{0, 0.5}}
+
+	intersection := Intersection(a, b)
+
+	if !intersection.Contains(LatLng{Lat: 0.25, Lng: 0}) {
+		t.Errorf("expected intersection to contain a point inside both circles")
+	}
+
+	if intersection.Contains(LatLng{Lat: -4.3, Lng: 0}) {
+		t.Errorf("expected intersection to not contain a point only inside a")
+	}
+}
+
+func TestDifferenceContains(t *testing.T) {
+	a := &Circle{Radius: 1000, Coordinates: []float64{0, 0}}
+	b := &Circle{Radius: 300, Coordinates: []float64{0, 0}}
+
+	difference := Difference(a, b)
+
+	if difference.Contains(LatLng{Lat: 0.001, Lng: 0.001}) {
+		t.Errorf("expected difference to exclude points inside b")
+	}
+
+	if !difference.Contains(LatLng{Lat: 0.007, Lng: 0}) {
+		t.Errorf("expected difference to contain points inside a but outside b")
+	}
+}
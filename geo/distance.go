@@ -0,0 +1,131 @@
+package geo
+
+import (
+	"github.com/golang/geo/s1"
+	"github.com/golang/geo/s2"
+)
+
+// Measurable is implemented by Geometry values that can additionally
+// report how far a point is from their boundary. This generalizes
+// Bleve's geo_distance sort (which only measures distance to a point)
+// to Polygon, Circle, Rectangle and Polyline; Point, MultiPolygon and
+// GeometryCollection do not implement it.
+type Measurable interface {
+	Geometry
+
+	// DistanceTo returns the distance in meters from latlng to the
+	// nearest point on the geometry's boundary, or 0 if latlng is
+	// contained in the geometry.
+	DistanceTo(latlng LatLng) float64
+
+	// NearestPoint returns the point on the geometry closest to latlng.
+	// If latlng is contained in the geometry, NearestPoint returns
+	// latlng itself.
+	NearestPoint(latlng LatLng) LatLng
+}
+
+// DistanceTo walks every edge of every loop (the outer ring and any
+// holes) and returns the distance in meters to the closest one, or 0 if
+// latlng is inside the polygon.
+func (p *Polygon) DistanceTo(latlng LatLng) float64 {
+	if p.Contains(latlng) {
+		return 0
+	}
+
+	minAngle, _ := p.nearestBoundaryPoint(latlng)
+	return minAngle.Angle().Radians() * EARTH_RADIUS * 1000
+}
+
+// NearestPoint returns the closest point on the polygon's boundary to
+// latlng, or latlng itself if it is already contained.
+func (p *Polygon) NearestPoint(latlng LatLng) LatLng {
+	if p.Contains(latlng) {
+		return latlng
+	}
+
+	_, nearest := p.nearestBoundaryPoint(latlng)
+	result := s2.LatLngFromPoint(nearest)
+	return LatLng{Lat: result.Lat.Degrees(), Lng: result.Lng.Degrees()}
+}
+
+func (p *Polygon) nearestBoundaryPoint(latlng LatLng) (s1.ChordAngle, s2.Point) {
+	point := s2.PointFromLatLng(s2.LatLngFromDegrees(latlng.Lat, latlng.Lng))
+
+	minAngle := s1.InfChordAngle()
+	var nearest s2.Point
+
+	for _, loop := range p.polygon.Loops() {
+		n := loop.NumVertices()
+		for i := 0; i < n; i++ {
+			a, b := loop.Vertex(i), loop.Vertex(i+1)
+			if d := s1.ChordAngleFromAngle(s2.DistanceFromSegment(point, a, b)); d < minAngle {
+				minAngle = d
+				nearest = s2.Project(point, a, b)
+			}
+		}
+	}
+
+	return minAngle, nearest
+}
+
+// DistanceTo returns the great-circle distance in meters from latlng to
+// the circle's center, minus its radius, clamped to 0 when latlng is
+// inside the circle.
+func (c *Circle) DistanceTo(latlng LatLng) float64 {
+	center := LatLng{Lat: c.Coordinates[1], Lng: c.Coordinates[0]}
+	distance := center.GreatCircleDistance(&latlng)*1000 - c.Radius
+
+	if distance < 0 {
+		return 0
+	}
+	return distance
+}
+
+// NearestPoint returns the point on the circle's boundary closest to
+// latlng, or latlng itself if it is already inside the circle.
+func (c *Circle) NearestPoint(latlng LatLng) LatLng {
+	if c.Contains(latlng) {
+		return latlng
+	}
+
+	center := s2.PointFromLatLng(s2.LatLngFromDegrees(c.Coordinates[1], c.Coordinates[0]))
+	point := s2.PointFromLatLng(s2.LatLngFromDegrees(latlng.Lat, latlng.Lng))
+
+	radiusAngle := s1.Angle(c.Radius / (EARTH_RADIUS * 1000))
+	nearest := s2.InterpolateAtDistance(radiusAngle, center, point)
+
+	result := s2.LatLngFromPoint(nearest)
+	return LatLng{Lat: result.Lat.Degrees(), Lng: result.Lng.Degrees()}
+}
+
+// DistanceTo returns the distance in meters from latlng to the
+// rectangle's boundary, or 0 if latlng is contained in it.
+func (r *Rectangle) DistanceTo(latlng LatLng) float64 {
+	rect := rectFromCorners(r.Coordinates)
+	ll := s2.LatLngFromDegrees(latlng.Lat, latlng.Lng)
+
+	if rect.ContainsLatLng(ll) {
+		return 0
+	}
+
+	return rect.DistanceToLatLng(ll).Radians() * EARTH_RADIUS * 1000
+}
+
+// NearestPoint returns the point on the rectangle's boundary closest to
+// latlng, or latlng itself if it is already contained.
+func (r *Rectangle) NearestPoint(latlng LatLng) LatLng {
+	if r.Contains(latlng) {
+		return latlng
+	}
+
+	rect := rectFromCorners(r.Coordinates)
+	ll := s2.LatLngFromDegrees(latlng.Lat, latlng.Lng)
+
+	lat := rect.Lat.ClampPoint(ll.Lat.Radians())
+	lng := rect.Lng.Project(ll.Lng.Radians())
+
+	return LatLng{
+		Lat: s1.Angle(lat).Degrees(),
+		Lng: s1.Angle(lng).Degrees(),
+	}
+}
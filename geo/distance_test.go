@@ -0,0 +1,76 @@
+package geo
+
+import "testing"
+
+func TestCircleDistanceTo(t *testing.T) {
+	circle := &Circle{
+		Radius:      297.82929433609627,
+		Coordinates: []float64{25.608530044555668, 43.07380969664719},
+	}
+
+	if got := circle.DistanceTo(LatLng{Lat: 43.07380969664719, Lng: 25.608530044555668}); got != 0 {
+		t.Errorf("expected 0 distance for the center, got %v", got)
+	}
+
+	outside := LatLng{Lat: 43.07415, Lng: 25.61671}
+	if got := circle.DistanceTo(outside); got <= 0 {
+		t.Errorf("expected a positive distance for a point outside the circle, got %v", got)
+	}
+}
+
+func TestCircleNearestPoint(t *testing.T) {
+	circle := &Circle{
+		Radius:      297.82929433609627,
+		Coordinates: []float64{25.608530044555668, 43.07380969664719},
+	}
+
+	inside := LatLng{Lat: 43.07409, Lng: 25.60987}
+	if got := circle.NearestPoint(inside); got != inside {
+		t.Errorf("expected NearestPoint to return the point itself when contained, got %v", got)
+	}
+
+	outside := LatLng{Lat: 43.07415, Lng: 25.61671}
+	nearest := circle.NearestPoint(outside)
+
+	if !circle.Contains(nearest) {
+		center := LatLng{Lat: circle.Coordinates[1], Lng: circle.Coordinates[0]}
+		d := center.GreatCircleDistance(&nearest) * 1000
+		if d > circle.Radius+1 {
+			t.Errorf("expected nearest point to sit on the circle boundary, got distance %v from center (radius %v)", d, circle.Radius)
+		}
+	}
+}
+
+func TestRectangleDistanceTo(t *testing.T) {
+	rectangle := &Rectangle{Coordinates: [][]float64{
+		{25.288888, 42.244444},
+		{25.322222, 42.288888},
+	}}
+
+	if got := rectangle.DistanceTo(LatLng{Lat: 42.266667, Lng: 25.305549}); got != 0 {
+		t.Errorf("expected 0 distance inside the rectangle, got %v", got)
+	}
+
+	if got := rectangle.DistanceTo(LatLng{Lat: 42.33029, Lng: 25.22495}); got <= 0 {
+		t.Errorf("expected a positive distance outside the rectangle, got %v", got)
+	}
+}
+
+func TestPolygonDistanceTo(t *testing.T) {
+	polygon := NewPolygon([][][]float64{{
+		{25.7244873046875, 43.11110313559475},
+		{25.726847648620605, 43.11417334786724},
+		{25.73268413543701, 43.110163243903585},
+		{25.728735923767093, 43.10712416198819},
+		{25.724401473999023, 43.10865938717618},
+		{25.7244873046875, 43.11110313559475},
+	}})
+
+	if got := polygon.DistanceTo(LatLng{Lat: 43.1089613, Lng: 25.7267396}); got != 0 {
+		t.Errorf("expected 0 distance inside the polygon, got %v", got)
+	}
+
+	if got := polygon.DistanceTo(LatLng{Lat: 43.0765023, Lng: 25.6312193}); got <= 0 {
+		t.Errorf("expected a positive distance outside the polygon, got %v", got)
+	}
+}
@@ -0,0 +1,245 @@
+package geo
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// geoJSONGeometry is the wire shape shared by standard GeoJSON geometries
+// and the Bleve-style {"type":"circle", ...} extension.
+type geoJSONGeometry struct {
+	Type        string            `json:"type"`
+	Coordinates json.RawMessage   `json:"coordinates"`
+	Radius      string            `json:"radius,omitempty"`
+	Geometries  []geoJSONGeometry `json:"geometries,omitempty"`
+}
+
+type geoJSONFeature struct {
+	Geometry geoJSONGeometry `json:"geometry"`
+}
+
+type geoJSONFeatureCollection struct {
+	Features []geoJSONFeature `json:"features"`
+}
+
+// ParseGeoJSON decodes a single GeoJSON geometry, or the Bleve-style
+// {"type":"circle","coordinates":[lon,lat],"radius":"500m"} extension,
+// into the matching Geometry implementation.
+func ParseGeoJSON(data []byte) (Geometry, error) {
+	var g geoJSONGeometry
+	if err := json.Unmarshal(data, &g); err != nil {
+		return nil, fmt.Errorf("geo: invalid GeoJSON geometry: %w", err)
+	}
+	return geometryFromGeoJSON(g)
+}
+
+// ParseFeatureCollection decodes a GeoJSON FeatureCollection into one
+// Geometry per feature, in document order.
+func ParseFeatureCollection(data []byte) ([]Geometry, error) {
+	var fc geoJSONFeatureCollection
+	if err := json.Unmarshal(data, &fc); err != nil {
+		return nil, fmt.Errorf("geo: invalid GeoJSON FeatureCollection: %w", err)
+	}
+
+	geometries := make([]Geometry, len(fc.Features))
+	for i, feature := range fc.Features {
+		g, err := geometryFromGeoJSON(feature.Geometry)
+		if err != nil {
+			return nil, fmt.Errorf("geo: feature %d: %w", i, err)
+		}
+		geometries[i] = g
+	}
+	return geometries, nil
+}
+
+func geometryFromGeoJSON(g geoJSONGeometry) (Geometry, error) {
+	switch g.Type {
+	case "Point":
+		var coordinates []float64
+		if err := json.Unmarshal(g.Coordinates, &coordinates); err != nil {
+			return nil, fmt.Errorf("geo: invalid Point coordinates: %w", err)
+		}
+		return &Point{Type: g.Type, Coordinates: coordinates}, nil
+	case "Polygon":
+		var coordinates [][][]float64
+		if err := json.Unmarshal(g.Coordinates, &coordinates); err != nil {
+			return nil, fmt.Errorf("geo: invalid Polygon coordinates: %w", err)
+		}
+		return NewPolygon(coordinates), nil
+	case "MultiPolygon":
+		var coordinates [][][][]float64
+		if err := json.Unmarshal(g.Coordinates, &coordinates); err != nil {
+			return nil, fmt.Errorf("geo: invalid MultiPolygon coordinates: %w", err)
+		}
+		return NewMultiPolygon(coordinates), nil
+	case "LineString":
+		var coordinates [][]float64
+		if err := json.Unmarshal(g.Coordinates, &coordinates); err != nil {
+			return nil, fmt.Errorf("geo: invalid LineString coordinates: %w", err)
+		}
+		return NewPolyline(coordinates, 0), nil
+	case "GeometryCollection":
+		geometries := make([]Geometry, len(g.Geometries))
+		for i, child := range g.Geometries {
+			geometry, err := geometryFromGeoJSON(child)
+			if err != nil {
+				return nil, fmt.Errorf("geo: geometry %d: %w", i, err)
+			}
+			geometries[i] = geometry
+		}
+		return NewGeometryCollection(geometries), nil
+	case "envelope":
+		var coordinates [][]float64
+		if err := json.Unmarshal(g.Coordinates, &coordinates); err != nil {
+			return nil, fmt.Errorf("geo: invalid envelope coordinates: %w", err)
+		}
+		return &Rectangle{Type: g.Type, Coordinates: coordinates}, nil
+	case "circle":
+		var coordinates []float64
+		if err := json.Unmarshal(g.Coordinates, &coordinates); err != nil {
+			return nil, fmt.Errorf("geo: invalid circle coordinates: %w", err)
+		}
+		radius, err := parseDistance(g.Radius)
+		if err != nil {
+			return nil, err
+		}
+		return &Circle{Type: g.Type, Radius: radius, Coordinates: coordinates}, nil
+	default:
+		return nil, fmt.Errorf("geo: unsupported GeoJSON geometry type %q", g.Type)
+	}
+}
+
+// parseDistance parses distance strings such as "1.5km", "500m", and
+// "2mi" into meters, the same unit suffixes Bleve accepts for its
+// geo_distance queries. A bare number is treated as already being in
+// meters.
+func parseDistance(s string) (float64, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, fmt.Errorf("geo: empty radius")
+	}
+
+	units := []struct {
+		suffix string
+		meters float64
+	}{
+		{"km", 1000},
+		{"mi", 1609.344},
+		{"m", 1},
+	}
+
+	for _, unit := range units {
+		if strings.HasSuffix(s, unit.suffix) {
+			value, err := strconv.ParseFloat(strings.TrimSuffix(s, unit.suffix), 64)
+			if err != nil {
+				return 0, fmt.Errorf("geo: invalid radius %q: %w", s, err)
+			}
+			return value * unit.meters, nil
+		}
+	}
+
+	value, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("geo: invalid radius %q: %w", s, err)
+	}
+	return value, nil
+}
+
+// formatDistance renders a distance in meters back into a Bleve-style
+// radius string, the inverse of parseDistance.
+func formatDistance(meters float64) string {
+	return strconv.FormatFloat(meters, 'g', -1, 64) + "m"
+}
+
+// MarshalGeoJSON renders the point as a GeoJSON Point geometry.
+func (p *Point) MarshalGeoJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type        string    `json:"type"`
+		Coordinates []float64 `json:"coordinates"`
+	}{Type: "Point", Coordinates: p.Coordinates})
+}
+
+// MarshalGeoJSON renders the polygon as a GeoJSON Polygon geometry.
+func (p *Polygon) MarshalGeoJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type        string        `json:"type"`
+		Coordinates [][][]float64 `json:"coordinates"`
+	}{Type: "Polygon", Coordinates: p.coordinates})
+}
+
+// MarshalGeoJSON renders the multi-polygon as a GeoJSON MultiPolygon
+// geometry.
+func (m *MultiPolygon) MarshalGeoJSON() ([]byte, error) {
+	coordinates := make([][][][]float64, len(m.Parts))
+	for i, part := range m.Parts {
+		coordinates[i] = part.coordinates
+	}
+
+	return json.Marshal(struct {
+		Type        string          `json:"type"`
+		Coordinates [][][][]float64 `json:"coordinates"`
+	}{Type: "MultiPolygon", Coordinates: coordinates})
+}
+
+// geoJSONMarshaler is implemented by every concrete Geometry type except
+// the composites returned by Union, Intersection and Difference; it
+// lets GeometryCollection.MarshalGeoJSON marshal its children without
+// a type switch.
+type geoJSONMarshaler interface {
+	MarshalGeoJSON() ([]byte, error)
+}
+
+// MarshalGeoJSON renders the collection as a GeoJSON GeometryCollection,
+// recursively marshaling each child geometry.
+func (g *GeometryCollection) MarshalGeoJSON() ([]byte, error) {
+	geometries := make([]json.RawMessage, len(g.Geometries))
+	for i, geometry := range g.Geometries {
+		m, ok := geometry.(geoJSONMarshaler)
+		if !ok {
+			return nil, fmt.Errorf("geo: geometry %d (%T) does not support MarshalGeoJSON", i, geometry)
+		}
+
+		data, err := m.MarshalGeoJSON()
+		if err != nil {
+			return nil, fmt.Errorf("geo: geometry %d: %w", i, err)
+		}
+		geometries[i] = data
+	}
+
+	return json.Marshal(struct {
+		Type       string            `json:"type"`
+		Geometries []json.RawMessage `json:"geometries"`
+	}{Type: "GeometryCollection", Geometries: geometries})
+}
+
+// MarshalGeoJSON renders the polyline as a GeoJSON LineString geometry.
+// BufferMeters has no standard GeoJSON representation, so a
+// round-tripped Polyline always has a buffer of 0.
+func (p *Polyline) MarshalGeoJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type        string      `json:"type"`
+		Coordinates [][]float64 `json:"coordinates"`
+	}{Type: "LineString", Coordinates: p.Coordinates})
+}
+
+// MarshalGeoJSON renders the circle using the Bleve-style
+// {"type":"circle", ...} extension.
+func (c *Circle) MarshalGeoJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type        string    `json:"type"`
+		Coordinates []float64 `json:"coordinates"`
+		Radius      string    `json:"radius"`
+	}{Type: "circle", Coordinates: c.Coordinates, Radius: formatDistance(c.Radius)})
+}
+
+// MarshalGeoJSON renders the rectangle using the "envelope" extension
+// ([[west,south],[east,north]] coordinates), since GeoJSON has no
+// standard bounding-box geometry.
+func (r *Rectangle) MarshalGeoJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type        string      `json:"type"`
+		Coordinates [][]float64 `json:"coordinates"`
+	}{Type: "envelope", Coordinates: r.Coordinates})
+}
@@ -0,0 +1,222 @@
+package geo
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseGeoJSONPoint(t *testing.T) {
+	got, err := ParseGeoJSON([]byte(`{"type":"Point","coordinates":[25.60853,43.07381]}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := &Point{Type: "Point", Coordinates: []float64{25.60853, 43.07381}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %+v", want)
+		t.Errorf("     got %+v", got)
+	}
+}
+
+func TestParseGeoJSONPolygon(t *testing.T) {
+	got, err := ParseGeoJSON([]byte(`{
+		"type": "Polygon",
+		"coordinates": [[[25.7244873046875,43.11110313559475],[25.726847648620605,43.11417334786724],[25.73268413543701,43.110163243903585],[25.728735923767093,43.10712416198819],[25.724401473999023,43.10865938717618],[25.7244873046875,43.11110313559475]]]
+	}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !got.Contains(LatLng{Lat: 43.1089613, Lng: 25.7267396}) {
+		t.Errorf("expected parsed polygon to contain the interior point")
+	}
+}
+
+func TestParseGeoJSONCircle(t *testing.T) {
+	got, err := ParseGeoJSON([]byte(`{"type":"circle","coordinates":[25.60853,43.07381],"radius":"500m"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	circle, ok := got.(*Circle)
+	if !ok {
+		t.Fatalf("expected *Circle, got %T", got)
+	}
+
+	if circle.Radius != 500 {
+		t.Errorf("expected radius 500, got %v", circle.Radius)
+	}
+}
+
+func TestParseGeoJSONEnvelope(t *testing.T) {
+	got, err := ParseGeoJSON([]byte(`{"type":"envelope","coordinates":[[25.288888,42.244444],[25.322222,42.288888]]}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !got.Contains(LatLng{Lat: 42.266667, Lng: 25.305549}) {
+		t.Errorf("expected parsed envelope to contain the interior point")
+	}
+}
+
+func TestParseGeoJSONUnsupportedType(t *testing.T) {
+	_, err := ParseGeoJSON([]byte(`{"type":"MultiLineString","coordinates":[]}`))
+	if err == nil {
+		t.Fatal("expected an error for an unsupported geometry type")
+	}
+}
+
+func TestParseGeoJSONMultiPolygon(t *testing.T) {
+	got, err := ParseGeoJSON([]byte(`{
+		"type": "MultiPolygon",
+		"coordinates": [
+			[[[25.7244873046875,43.11110313559475],[25.726847648620605,43.11417334786724],[25.73268413543701,43.110163243903585],[25.728735923767093,43.10712416198819],[25.724401473999023,43.10865938717618],[25.7244873046875,43.11110313559475]]],
+			[[[-1,-1],[-1,1],[1,1],[1,-1],[-1,-1]]]
+		]
+	}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	multiPolygon, ok := got.(*MultiPolygon)
+	if !ok {
+		t.Fatalf("expected *MultiPolygon, got %T", got)
+	}
+
+	if !multiPolygon.Contains(LatLng{Lat: 43.1089613, Lng: 25.7267396}) {
+		t.Errorf("expected point in the first part to be contained")
+	}
+
+	if !multiPolygon.Contains(LatLng{Lat: 0, Lng: 0}) {
+		t.Errorf("expected point in the second part to be contained")
+	}
+}
+
+func TestParseGeoJSONLineString(t *testing.T) {
+	got, err := ParseGeoJSON([]byte(`{"type":"LineString","coordinates":[[25.60853,43.07381],[25.61671,43.07415]]}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	polyline, ok := got.(*Polyline)
+	if !ok {
+		t.Fatalf("expected *Polyline, got %T", got)
+	}
+
+	if !polyline.Contains(LatLng{Lat: 43.07398, Lng: 25.61262}) {
+		t.Errorf("expected parsed polyline to contain a point on the line")
+	}
+}
+
+func TestParseGeoJSONGeometryCollection(t *testing.T) {
+	got, err := ParseGeoJSON([]byte(`{
+		"type": "GeometryCollection",
+		"geometries": [
+			{"type":"Point","coordinates":[25.60853,43.07381]},
+			{"type":"circle","coordinates":[0,0],"radius":"300m"}
+		]
+	}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	collection, ok := got.(*GeometryCollection)
+	if !ok {
+		t.Fatalf("expected *GeometryCollection, got %T", got)
+	}
+
+	if len(collection.Geometries) != 2 {
+		t.Fatalf("expected 2 geometries, got %d", len(collection.Geometries))
+	}
+
+	if !collection.Contains(LatLng{Lat: 0.001, Lng: 0.001}) {
+		t.Errorf("expected collection to contain a point inside the circle")
+	}
+}
+
+func TestGeometryCollectionMarshalGeoJSONRoundTrip(t *testing.T) {
+	collection := NewGeometryCollection([]Geometry{
+		&Point{Coordinates: []float64{25.60853, 43.07381}},
+		&Circle{Radius: 300, Coordinates: []float64{0, 0}},
+	})
+
+	data, err := collection.MarshalGeoJSON()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got, err := ParseGeoJSON(data)
+	if err != nil {
+		t.Fatalf("unexpected error reparsing: %v", err)
+	}
+
+	if !got.(*GeometryCollection).Contains(LatLng{Lat: 0.001, Lng: 0.001}) {
+		t.Errorf("expected round-tripped collection to contain a point inside the circle")
+	}
+}
+
+func TestParseFeatureCollection(t *testing.T) {
+	geometries, err := ParseFeatureCollection([]byte(`{
+		"type": "FeatureCollection",
+		"features": [
+			{"type":"Feature","geometry":{"type":"Point","coordinates":[25.60853,43.07381]}},
+			{"type":"Feature","geometry":{"type":"circle","coordinates":[25.60853,43.07381],"radius":"1.5km"}}
+		]
+	}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(geometries) != 2 {
+		t.Fatalf("expected 2 geometries, got %d", len(geometries))
+	}
+
+	circle, ok := geometries[1].(*Circle)
+	if !ok {
+		t.Fatalf("expected *Circle, got %T", geometries[1])
+	}
+
+	if circle.Radius != 1500 {
+		t.Errorf("expected radius 1500, got %v", circle.Radius)
+	}
+}
+
+func TestParseDistance(t *testing.T) {
+	cases := []struct {
+		in   string
+		want float64
+	}{
+		{"500m", 500},
+		{"1.5km", 1500},
+		{"2mi", 3218.688},
+		{"42", 42},
+	}
+
+	for _, c := range cases {
+		got, err := parseDistance(c.in)
+		if err != nil {
+			t.Fatalf("unexpected error for %q: %v", c.in, err)
+		}
+		if got != c.want {
+			t.Errorf("parseDistance(%q): expected %v, got %v", c.in, c.want, got)
+		}
+	}
+}
+
+func TestMarshalGeoJSONRoundTrip(t *testing.T) {
+	point := &Point{Coordinates: []float64{25.60853, 43.07381}}
+
+	data, err := point.MarshalGeoJSON()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got, err := ParseGeoJSON(data)
+	if err != nil {
+		t.Fatalf("unexpected error reparsing: %v", err)
+	}
+
+	if !reflect.DeepEqual(got.(*Point).Coordinates, point.Coordinates) {
+		t.Errorf("expected round-tripped coordinates %v, got %v", point.Coordinates, got.(*Point).Coordinates)
+	}
+}
@@ -3,6 +3,7 @@ package geo
 import (
 	"math"
 
+	"github.com/golang/geo/r1"
 	"github.com/golang/geo/s1"
 	"github.com/golang/geo/s2"
 )
@@ -57,45 +58,120 @@ func (p *Point) CircleBound() (LatLng, float64) {
 	return LatLng{Lng: p.Coordinates[0], Lat: p.Coordinates[1]}, 0
 }
 
-// Polygon is representing a polygon
-// line structure.
+// Polygon is representing a polygon line structure. coordinates[0] is
+// the outer boundary ring; coordinates[1:] are holes cut out of it,
+// following the GeoJSON ring convention.
 type Polygon struct {
 	Type        string
 	coordinates [][][]float64
 
-	loop *s2.Loop
+	polygon *s2.Polygon
 }
 
-// NewPolygon creates a new polygon for the provided
-// coordinates.
+// NewPolygon creates a new polygon for the provided coordinates. Ring 0
+// is the outer boundary; any additional rings are treated as holes, so
+// Contains returns true only for points inside the outer ring and
+// outside every hole.
 func NewPolygon(coordinates [][][]float64) *Polygon {
-	points := make([]s2.Point, len(coordinates[0]))
+	loops := make([]*s2.Loop, len(coordinates))
+
+	var shellVertex s2.Point
+	for i, ring := range coordinates {
+		loop := loopFromRing(ring)
+
+		switch {
+		case i == 0:
+			shellVertex = loop.Vertex(0)
+		case loop.ContainsPoint(shellVertex):
+			// loopFromRing applies the same reversal to every ring,
+			// so a hole only comes out enclosing its own small region
+			// when it is wound the same way as the shell; a hole wound
+			// opposite the shell (the RFC7946 convention) comes out as
+			// its complement. Instead of assuming either winding we
+			// check the actual result: a properly oriented hole can
+			// never contain a point of the outer shell, so if this
+			// one does, it's representing the hole's complement and
+			// needs inverting back to the small hole region.
+			loop.Invert()
+		}
+
+		loops[i] = loop
+	}
+
+	return &Polygon{Type: "Polygon", coordinates: coordinates, polygon: s2.PolygonFromLoops(loops)}
+}
 
-	for i, coordinate := range coordinates[0] {
-		points[len(coordinates[0])-1-i] = s2.PointFromLatLng(s2.LatLngFromDegrees(coordinate[1], coordinate[0]))
+// loopFromRing builds an s2.Loop from a single GeoJSON ring
+// ([point][lon,lat]), reversing it to the counter-clockwise winding s2
+// expects.
+func loopFromRing(ring [][]float64) *s2.Loop {
+	points := make([]s2.Point, len(ring))
+
+	for i, coordinate := range ring {
+		points[len(ring)-1-i] = s2.PointFromLatLng(s2.LatLngFromDegrees(coordinate[1], coordinate[0]))
 	}
 
-	loop := s2.LoopFromPoints(points)
-	return &Polygon{coordinates: coordinates, loop: loop}
+	return s2.LoopFromPoints(points)
 }
 
 // Contains checks whether the LatLng is contained in the
 // polygon. It returns true if the LatLng is contained in
-// the polygon and false otherwise.
+// the outer ring and outside every hole, and false otherwise.
 func (p *Polygon) Contains(latlng LatLng) bool {
-	return p.loop.ContainsPoint(s2.PointFromLatLng(s2.LatLngFromDegrees(latlng.Lat, latlng.Lng)))
+	return p.polygon.ContainsPoint(s2.PointFromLatLng(s2.LatLngFromDegrees(latlng.Lat, latlng.Lng)))
 }
 
 func (p *Polygon) CircleBound() (LatLng, float64) {
-	points := make([]s2.Point, len(p.coordinates[0]))
+	cap := p.polygon.CapBound()
+	latlng := s2.LatLngFromPoint(cap.Center())
 
-	for i, coordinate := range p.coordinates[0] {
-		points[len(p.coordinates[0])-1-i] = s2.PointFromLatLng(s2.LatLngFromDegrees(coordinate[1], coordinate[0]))
+	return LatLng{
+		Lng: latlng.Lng.Degrees(),
+		Lat: latlng.Lat.Degrees(),
+	}, float64(cap.Radius() * 6371000)
+}
+
+// MultiPolygon represents a GeoJSON MultiPolygon: a set of independent
+// Polygon parts, each of which may itself have holes.
+type MultiPolygon struct {
+	Type  string
+	Parts []*Polygon
+}
+
+// NewMultiPolygon creates a MultiPolygon from the provided parts, where
+// each part follows the same ring convention as NewPolygon: ring 0 is
+// the outer boundary and rings 1..N are holes.
+func NewMultiPolygon(coordinates [][][][]float64) *MultiPolygon {
+	parts := make([]*Polygon, len(coordinates))
+	for i, part := range coordinates {
+		parts[i] = NewPolygon(part)
 	}
 
-	cap := s2.LoopFromPoints(points).CapBound()
-	latlng := s2.LatLngFromPoint(cap.Center())
+	return &MultiPolygon{Type: "MultiPolygon", Parts: parts}
+}
+
+// Contains returns true if the LatLng is contained in any part of the
+// MultiPolygon.
+func (m *MultiPolygon) Contains(latlng LatLng) bool {
+	for _, part := range m.Parts {
+		if part.Contains(latlng) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *MultiPolygon) CircleBound() (LatLng, float64) {
+	if len(m.Parts) == 0 {
+		return LatLng{}, 0
+	}
+
+	cap := m.Parts[0].polygon.CapBound()
+	for _, part := range m.Parts[1:] {
+		cap = cap.Union(part.polygon.CapBound())
+	}
 
+	latlng := s2.LatLngFromPoint(cap.Center())
 	return LatLng{
 		Lng: latlng.Lng.Degrees(),
 		Lat: latlng.Lat.Degrees(),
@@ -121,33 +197,57 @@ func (c *Circle) Contains(latlng LatLng) bool {
 	return cp.ContainsPoint(b)
 }
 
+// CircleBound returns the circle's own center and radius, except when
+// the radius reaches far enough to cover a pole: an off-pole center
+// would then understate the area the circle actually spans at
+// longitudes it never passes through, so the bound is re-centered on
+// the nearest pole (latitude clamped to ±90) with a radius that still
+// encloses the original cap. A pole-centered cap is the same at every
+// longitude, which is exactly the "full 360°" a pole-crossing circle
+// covers.
 func (c *Circle) CircleBound() (LatLng, float64) {
-	return LatLng{Lng: c.Coordinates[0], Lat: c.Coordinates[1]}, c.Radius
+	earthRadius := float64(EARTH_RADIUS * 1000)
+	radiusAngle := c.Radius / earthRadius
+	distanceToPole := math.Pi/2 - math.Abs(c.Coordinates[1]*math.Pi/180)
+
+	if radiusAngle < distanceToPole {
+		return LatLng{Lng: c.Coordinates[0], Lat: c.Coordinates[1]}, c.Radius
+	}
+
+	pole := 90.0
+	if c.Coordinates[1] < 0 {
+		pole = -90.0
+	}
+
+	return LatLng{Lat: pole, Lng: 0}, (radiusAngle + distanceToPole) * earthRadius
 }
 
+// Rectangle is a lon/lat bounding box given as two [lon,lat] corners:
+// Coordinates[0] is the southwest corner and Coordinates[1] is the
+// northeast corner, following Bleve's TopLeft/BottomRight convention.
 type Rectangle struct {
 	Type        string
 	Coordinates [][]float64
 }
 
-func (r *Rectangle) Contains(latlng LatLng) bool {
-	rect := s2.RectFromLatLng(s2.LatLngFromDegrees(r.Coordinates[0][1], r.Coordinates[0][0]))
-
-	for i := 1; i < len(r.Coordinates[0]); i++ {
-		rect = rect.AddPoint(s2.LatLngFromDegrees(r.Coordinates[i][1], r.Coordinates[i][0]))
+// NewRectangle creates a Rectangle from its southwest and northeast
+// corners. A northeast corner whose longitude is less than the
+// southwest corner's (sw.Lng > ne.Lng) is treated as a box that wraps
+// the antimeridian rather than an inverted box.
+func NewRectangle(sw, ne LatLng) *Rectangle {
+	return &Rectangle{
+		Type:        "envelope",
+		Coordinates: [][]float64{{sw.Lng, sw.Lat}, {ne.Lng, ne.Lat}},
 	}
+}
 
+func (r *Rectangle) Contains(latlng LatLng) bool {
+	rect := rectFromCorners(r.Coordinates)
 	return rect.ContainsLatLng(s2.LatLngFromDegrees(latlng.Lat, latlng.Lng))
 }
 
 func (r *Rectangle) CircleBound() (LatLng, float64) {
-	rect := s2.RectFromLatLng(s2.LatLngFromDegrees(r.Coordinates[0][1], r.Coordinates[0][0]))
-
-	for i := 1; i < len(r.Coordinates[0]); i++ {
-		rect = rect.AddPoint(s2.LatLngFromDegrees(r.Coordinates[i][1], r.Coordinates[i][0]))
-	}
-
-	cap := rect.CapBound()
+	cap := rectFromCorners(r.Coordinates).CapBound()
 	latlng := s2.LatLngFromPoint(cap.Center())
 
 	return LatLng{
@@ -155,3 +255,19 @@ func (r *Rectangle) CircleBound() (LatLng, float64) {
 		Lng: latlng.Lng.Degrees(),
 	}, float64(cap.Radius() * 6371000)
 }
+
+// rectFromCorners builds an s2.Rect from a Rectangle's southwest and
+// northeast [lon,lat] corners. Unlike repeatedly AddPoint-ing corners
+// into an s2.Rect (which always picks the shorter of the two possible
+// longitude spans), it builds the longitude interval directly from sw
+// to ne so a box with sw.Lng > ne.Lng correctly wraps the antimeridian
+// instead of collapsing to the short way around.
+func rectFromCorners(coordinates [][]float64) s2.Rect {
+	sw := s2.LatLngFromDegrees(coordinates[0][1], coordinates[0][0])
+	ne := s2.LatLngFromDegrees(coordinates[1][1], coordinates[1][0])
+
+	return s2.Rect{
+		Lat: r1.Interval{Lo: sw.Lat.Radians(), Hi: ne.Lat.Radians()},
+		Lng: s1.IntervalFromEndpoints(sw.Lng.Radians(), ne.Lng.Radians()),
+	}
+}
@@ -102,6 +102,148 @@ func TestPolygonContains(t *testing.T) {
 	}
 }
 
+func TestPolygonWithHoleContains(t *testing.T) {
+	polygon := NewPolygon([][][]float64{
+		{
+			{25.7244873046875, 43.11110313559475},
+			{25.726847648620605, 43.11417334786724},
+			{25.73268413543701, 43.110163243903585},
+			{25.728735923767093, 43.10712416198819},
+			{25.724401473999023, 43.10865938717618},
+			{25.7244873046875, 43.11110313559475},
+		},
+		{
+			{25.7270, 43.1100},
+			{25.7280, 43.1100},
+			{25.7280, 43.1105},
+			{25.7270, 43.1105},
+			{25.7270, 43.1100},
+		},
+	})
+
+	cases := []struct {
+		in   LatLng
+		want bool
+	}{
+		{
+			// Inside the outer ring, outside the hole.
+			LatLng{Lat: 43.1089613, Lng: 25.7267396},
+			true,
+		},
+		{
+			// Inside the hole.
+			LatLng{Lat: 43.1102, Lng: 25.7275},
+			false,
+		},
+	}
+
+	for _, c := range cases {
+		got := polygon.Contains(c.in)
+
+		if got != c.want {
+			t.Errorf("expected %t", c.want)
+			t.Errorf("     got %t", got)
+		}
+	}
+}
+
+func TestPolygonWithHoleWoundSameAsShellContains(t *testing.T) {
+	// The hole ring here is wound the same direction as the shell,
+	// which violates the RFC7946 convention but is common in
+	// hand-authored and Shapefile/OSM-derived GeoJSON.
+	polygon := NewPolygon([][][]float64{
+		{
+			{0, 0},
+			{0, 10},
+			{10, 10},
+			{10, 0},
+			{0, 0},
+		},
+		{
+			{4, 4},
+			{4, 6},
+			{6, 6},
+			{6, 4},
+			{4, 4},
+		},
+	})
+
+	cases := []struct {
+		in   LatLng
+		want bool
+	}{
+		{
+			// Inside the hole.
+			LatLng{Lat: 5, Lng: 5},
+			false,
+		},
+		{
+			// Inside the shell, outside the hole.
+			LatLng{Lat: 8, Lng: 8},
+			true,
+		},
+	}
+
+	for _, c := range cases {
+		got := polygon.Contains(c.in)
+
+		if got != c.want {
+			t.Errorf("expected %t", c.want)
+			t.Errorf("     got %t", got)
+		}
+	}
+}
+
+func TestMultiPolygonContains(t *testing.T) {
+	multiPolygon := NewMultiPolygon([][][][]float64{
+		{{
+			{25.7244873046875, 43.11110313559475},
+			{25.726847648620605, 43.11417334786724},
+			{25.73268413543701, 43.110163243903585},
+			{25.728735923767093, 43.10712416198819},
+			{25.724401473999023, 43.10865938717618},
+			{25.7244873046875, 43.11110313559475},
+		}},
+		{{
+			{-1, -1},
+			{-1, 1},
+			{1, 1},
+			{1, -1},
+			{-1, -1},
+		}},
+	})
+
+	cases := []struct {
+		in   LatLng
+		want bool
+	}{
+		{
+			// Inside the first part.
+			LatLng{Lat: 43.1089613, Lng: 25.7267396},
+			true,
+		},
+		{
+			// Inside the second part.
+			LatLng{Lat: 0, Lng: 0},
+			true,
+		},
+		{
+			// Inside neither part.
+			LatLng{Lat: 43.0765023, Lng: 25.6312193},
+			false,
+		},
+	}
+
+	for _, c := range cases {
+		got := multiPolygon.Contains(c.in)
+
+		if got != c.want {
+			t.Errorf("expected %t", c.want)
+			t.Errorf("     got %t", got)
+		}
+	}
+}
+
 func TestCountryPolygonContains(t *testing.T) {
 	cp := readCountryGeoJson()
 
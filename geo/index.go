@@ -0,0 +1,111 @@
+package geo
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/golang/geo/s2"
+)
+
+// indexLevel is the fixed S2 cell level Index stores and queries
+// against. Using a single fixed level (rather than letting
+// s2.RegionCoverer pick whatever levels minimize cell count) means two
+// cells can only ever be equal or disjoint, so Query and QueryRegion
+// never need to walk the cell hierarchy to find candidates. Level 10
+// cells are ~10km across, a reasonable default for country/region-sized
+// shapes; callers indexing much smaller geometries may want a finer
+// index.
+const indexLevel = 10
+
+// ID identifies a geometry stored in an Index. Callers choose what a
+// meaningful identifier looks like for their data (a database key, a
+// feature name, a row number, ...).
+type ID interface{}
+
+type indexEntry struct {
+	id       ID
+	geometry Geometry
+}
+
+// Index is a spatial index over many Geometry values, backed by S2 cell
+// covers. Query uses the covers as a cheap prefilter and only runs
+// exact Contains checks against the surviving candidates, so lookups
+// stay sub-linear even with tens of thousands of indexed shapes.
+// QueryRegion matches on the covers alone, without an exact check.
+type Index struct {
+	coverer s2.RegionCoverer
+	cells   map[s2.CellID][]indexEntry
+}
+
+// NewIndex creates an empty spatial index.
+func NewIndex() *Index {
+	return &Index{
+		coverer: s2.RegionCoverer{MinLevel: indexLevel, MaxLevel: indexLevel, MaxCells: 64},
+		cells:   make(map[s2.CellID][]indexEntry),
+	}
+}
+
+// NewIndexFromGeoJSON builds an Index directly from a GeoJSON
+// FeatureCollection read from r, using each feature's 0-based position
+// in the collection as its ID.
+func NewIndexFromGeoJSON(r io.Reader) (*Index, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("geo: reading FeatureCollection: %w", err)
+	}
+
+	geometries, err := ParseFeatureCollection(data)
+	if err != nil {
+		return nil, err
+	}
+
+	index := NewIndex()
+	for i, geometry := range geometries {
+		index.Add(i, geometry)
+	}
+	return index, nil
+}
+
+// Add inserts geometry into the index under id. Re-adding the same id
+// does not replace a previous entry; it is appended alongside it.
+func (idx *Index) Add(id ID, geometry Geometry) {
+	entry := indexEntry{id: id, geometry: geometry}
+	for _, cell := range idx.coverer.Covering(capBound(geometry)) {
+		idx.cells[cell] = append(idx.cells[cell], entry)
+	}
+}
+
+// Query returns the IDs of every indexed geometry that contains latlng.
+func (idx *Index) Query(latlng LatLng) []ID {
+	cell := s2.CellIDFromLatLng(s2.LatLngFromDegrees(latlng.Lat, latlng.Lng)).Parent(indexLevel)
+
+	var ids []ID
+	for _, entry := range idx.cells[cell] {
+		if entry.geometry.Contains(latlng) {
+			ids = append(ids, entry.id)
+		}
+	}
+	return ids
+}
+
+// QueryRegion returns the IDs of every indexed geometry whose cell
+// cover intersects region's cell cover. Geometry has no shape-to-shape
+// intersection primitive, so this is a cover-level test rather than an
+// exact one: it may include geometries that only share a covering cell
+// with region without actually intersecting it near the cell's edges.
+func (idx *Index) QueryRegion(region Geometry) []ID {
+	seen := make(map[ID]bool)
+	var ids []ID
+
+	for _, cell := range idx.coverer.Covering(capBound(region)) {
+		for _, entry := range idx.cells[cell] {
+			if seen[entry.id] {
+				continue
+			}
+			seen[entry.id] = true
+			ids = append(ids, entry.id)
+		}
+	}
+
+	return ids
+}
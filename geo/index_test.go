@@ -0,0 +1,52 @@
+package geo
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIndexQuery(t *testing.T) {
+	index := NewIndex()
+	index.Add("sofia", &Circle{Radius: 20000, Coordinates: []float64{23.3219, 42.6977}})
+	index.Add("plovdiv", &Circle{Radius: 20000, Coordinates: []float64{24.7453, 42.1354}})
+
+	ids := index.Query(LatLng{Lat: 42.6977, Lng: 23.3219})
+	if len(ids) != 1 || ids[0] != "sofia" {
+		t.Errorf("expected [sofia], got %v", ids)
+	}
+
+	ids = index.Query(LatLng{Lat: 10, Lng: 10})
+	if len(ids) != 0 {
+		t.Errorf("expected no matches far from either circle, got %v", ids)
+	}
+}
+
+func TestIndexQueryRegion(t *testing.T) {
+	index := NewIndex()
+	index.Add("sofia", &Circle{Radius: 20000, Coordinates: []float64{23.3219, 42.6977}})
+	index.Add("plovdiv", &Circle{Radius: 20000, Coordinates: []float64{24.7453, 42.1354}})
+
+	ids := index.QueryRegion(&Circle{Radius: 20000, Coordinates: []float64{23.3219, 42.6977}})
+	if len(ids) != 1 || ids[0] != "sofia" {
+		t.Errorf("expected [sofia], got %v", ids)
+	}
+}
+
+func TestNewIndexFromGeoJSON(t *testing.T) {
+	r := strings.NewReader(`{
+		"type": "FeatureCollection",
+		"features": [
+			{"type":"Feature","geometry":{"type":"circle","coordinates":[23.3219,42.6977],"radius":"20km"}}
+		]
+	}`)
+
+	index, err := NewIndexFromGeoJSON(r)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ids := index.Query(LatLng{Lat: 42.6977, Lng: 23.3219})
+	if len(ids) != 1 || ids[0] != 0 {
+		t.Errorf("expected [0], got %v", ids)
+	}
+}
@@ -0,0 +1,105 @@
+package geo
+
+import (
+	"github.com/golang/geo/s1"
+	"github.com/golang/geo/s2"
+)
+
+// Polyline represents an open path such as a route, river, or road.
+// Unlike the other Geometry implementations, a line has no interior;
+// Contains instead treats the line as having width, so a point is
+// "contained" when it falls within BufferMeters of the nearest segment.
+// BufferMeters defaults to 0 for strict on-line containment (within
+// the fixed onLineToleranceMeters tolerance of 1cm).
+type Polyline struct {
+	Type         string
+	Coordinates  [][]float64
+	BufferMeters float64
+
+	polyline *s2.Polyline
+}
+
+// NewPolyline creates a Polyline from [lon,lat] coordinates with the
+// given containment buffer, in meters.
+func NewPolyline(coordinates [][]float64, bufferMeters float64) *Polyline {
+	points := make([]s2.Point, len(coordinates))
+	for i, coordinate := range coordinates {
+		points[i] = s2.PointFromLatLng(s2.LatLngFromDegrees(coordinate[1], coordinate[0]))
+	}
+
+	polyline := s2.Polyline(points)
+	return &Polyline{
+		Type:         "LineString",
+		Coordinates:  coordinates,
+		BufferMeters: bufferMeters,
+		polyline:     &polyline,
+	}
+}
+
+// onLineToleranceMeters absorbs the small numeric error that
+// non-geodesic interpolation (e.g. in hand-written GeoJSON fixtures)
+// introduces relative to the true geodesic, so a point meant to sit
+// exactly on the line isn't rejected by a hard zero-buffer comparison.
+const onLineToleranceMeters = 0.01
+
+// Contains returns true if latlng is within BufferMeters of the
+// polyline.
+func (p *Polyline) Contains(latlng LatLng) bool {
+	_, chordAngle := p.nearestEdgePoint(latlng)
+	buffer := p.BufferMeters + onLineToleranceMeters
+	return chordAngle <= s1.ChordAngleFromAngle(s1.Angle(buffer/(EARTH_RADIUS*1000)))
+}
+
+// CircleBound returns the smallest cap enclosing the polyline, widened
+// by BufferMeters to account for the containment buffer.
+func (p *Polyline) CircleBound() (LatLng, float64) {
+	cap := p.polyline.CapBound()
+	latlng := s2.LatLngFromPoint(cap.Center())
+
+	return LatLng{
+		Lat: latlng.Lat.Degrees(),
+		Lng: latlng.Lng.Degrees(),
+	}, float64(cap.Radius()*EARTH_RADIUS*1000) + p.BufferMeters
+}
+
+// DistanceTo returns the distance in meters from latlng to the nearest
+// point on the polyline, minus BufferMeters, clamped to 0.
+func (p *Polyline) DistanceTo(latlng LatLng) float64 {
+	_, chordAngle := p.nearestEdgePoint(latlng)
+	distance := chordAngle.Angle().Radians()*EARTH_RADIUS*1000 - p.BufferMeters
+
+	if distance < 0 {
+		return 0
+	}
+	return distance
+}
+
+// NearestPoint returns the point on the polyline closest to latlng, or
+// latlng itself if it already falls within BufferMeters of the line.
+func (p *Polyline) NearestPoint(latlng LatLng) LatLng {
+	if p.Contains(latlng) {
+		return latlng
+	}
+
+	nearest, _ := p.nearestEdgePoint(latlng)
+	result := s2.LatLngFromPoint(nearest)
+	return LatLng{Lat: result.Lat.Degrees(), Lng: result.Lng.Degrees()}
+}
+
+func (p *Polyline) nearestEdgePoint(latlng LatLng) (s2.Point, s1.ChordAngle) {
+	point := s2.PointFromLatLng(s2.LatLngFromDegrees(latlng.Lat, latlng.Lng))
+
+	minAngle := s1.InfChordAngle()
+	var nearest s2.Point
+
+	vertices := *p.polyline
+	for i := 0; i < len(vertices)-1; i++ {
+		a, b := vertices[i], vertices[i+1]
+		if d := s1.ChordAngleFromAngle(s2.DistanceFromSegment(point, a, b)); d < minAngle {
+			minAngle = d
+			nearest = s2.Project(point, a, b)
+		}
+	}
+
+	return nearest, minAngle
+}
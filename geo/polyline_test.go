@@ -0,0 +1,69 @@
+package geo
+
+import "testing"
+
+func TestPolylineContains(t *testing.T) {
+	polyline := NewPolyline([][]float64{
+		{25.60853, 43.07381},
+		{25.61671, 43.07415},
+	}, 50)
+
+	cases := []struct {
+		in   LatLng
+		want bool
+	}{
+		{
+			// On the line.
+			LatLng{Lat: 43.07398, Lng: 25.61262},
+			true,
+		},
+		{
+			// Far from the line, outside the buffer.
+			LatLng{Lat: 43.1, Lng: 25.61262},
+			false,
+		},
+	}
+
+	for _, c := range cases {
+		got := polyline.Contains(c.in)
+
+		if got != c.want {
+			t.Errorf("expected %t", c.want)
+			t.Errorf("     got %t", got)
+		}
+	}
+}
+
+func TestPolylineZeroBufferContains(t *testing.T) {
+	polyline := NewPolyline([][]float64{
+		{25.60853, 43.07381},
+		{25.61671, 43.07415},
+	}, 0)
+
+	if polyline.Contains(LatLng{Lat: 43.1, Lng: 25.61262}) {
+		t.Errorf("expected a point far from the line to not be contained")
+	}
+}
+
+func TestPolylineDistanceTo(t *testing.T) {
+	polyline := NewPolyline([][]float64{
+		{25.60853, 43.07381},
+		{25.61671, 43.07415},
+	}, 0)
+
+	if got := polyline.DistanceTo(LatLng{Lat: 43.1, Lng: 25.61262}); got <= 0 {
+		t.Errorf("expected a positive distance, got %v", got)
+	}
+}
+
+func TestPolylineCircleBound(t *testing.T) {
+	polyline := NewPolyline([][]float64{
+		{25.60853, 43.07381},
+		{25.61671, 43.07415},
+	}, 50)
+
+	_, radius := polyline.CircleBound()
+	if radius <= 50 {
+		t.Errorf("expected the bound radius to include the 50m buffer, got %v", radius)
+	}
+}